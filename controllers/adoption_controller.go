@@ -0,0 +1,261 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	rbacv1 "k8s.io/api/rbac/v1"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/RHEcosystemAppEng/dbaas-operator/api/v1alpha1"
+)
+
+// AdoptionReconciler takes ownership of pre-existing provider objects,
+// Roles and RoleBindings that match a DBaaSConnection/DBaaSInventory by
+// name and namespace but were created before this operator was installed
+// and therefore lack an ownerReference.
+type AdoptionReconciler struct {
+	*DBaaSReconciler
+}
+
+//+kubebuilder:rbac:groups=dbaas.redhat.com,resources=dbaasconnections;dbaasinventories,verbs=get;list;watch;update
+//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles;rolebindings,verbs=get;list;watch;update
+//+kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
+
+func (r *AdoptionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	var connection v1alpha1.DBaaSConnection
+	if err := r.Get(ctx, req.NamespacedName, &connection); err == nil {
+		kind, err := r.providerObjectKindForConnection(ctx, &connection)
+		if err != nil {
+			logger.Error(err, "Error resolving provider connection kind", "connection", req.NamespacedName)
+			return ctrl.Result{}, err
+		}
+		if err := r.adopt(ctx, &connection, kind, logger); err != nil {
+			return ctrl.Result{}, err
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+
+	// A DBaaSConnection and a DBaaSInventory can share the same name and
+	// namespace, so both are checked independently rather than returning
+	// after the first match.
+	var inventory v1alpha1.DBaaSInventory
+	if err := r.Get(ctx, req.NamespacedName, &inventory); err == nil {
+		kind, err := r.providerObjectKindForInventory(ctx, &inventory)
+		if err != nil {
+			logger.Error(err, "Error resolving provider inventory kind", "inventory", req.NamespacedName)
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, r.adopt(ctx, &inventory, kind, logger)
+	} else if !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// providerObjectKindForConnection resolves the provider object Kind for a
+// DBaaSConnection by following InventoryRef to the referenced
+// DBaaSInventory and from there to its DBaaSProvider's ConnectionKind - the
+// same Kind watchDBaaSProviderObject registers a watch for.
+func (r *AdoptionReconciler) providerObjectKindForConnection(ctx context.Context, connection *v1alpha1.DBaaSConnection) (string, error) {
+	var inventory v1alpha1.DBaaSInventory
+	key := types.NamespacedName{Name: connection.Spec.InventoryRef.Name, Namespace: connection.Spec.InventoryRef.Namespace}
+	if err := r.Get(ctx, key, &inventory); err != nil {
+		return "", err
+	}
+	provider, err := r.getDBaaSProvider(inventory.Spec.ProviderRef.Name, ctx)
+	if err != nil {
+		return "", err
+	}
+	return provider.Spec.ConnectionKind, nil
+}
+
+// providerObjectKindForInventory resolves the provider object Kind for a
+// DBaaSInventory via its ProviderRef's DBaaSProvider.Spec.InventoryKind.
+func (r *AdoptionReconciler) providerObjectKindForInventory(ctx context.Context, inventory *v1alpha1.DBaaSInventory) (string, error) {
+	provider, err := r.getDBaaSProvider(inventory.Spec.ProviderRef.Name, ctx)
+	if err != nil {
+		return "", err
+	}
+	return provider.Spec.InventoryKind, nil
+}
+
+// adopt takes ownership of the Role, RoleBinding and (if providerObjectKind
+// is set) provider object matching owner's name and namespace, provided
+// they exist and don't already have an ownerReference.
+func (r *AdoptionReconciler) adopt(ctx context.Context, owner client.Object, providerObjectKind string, logger logr.Logger) error {
+	candidates := []client.Object{
+		&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: owner.GetName(), Namespace: owner.GetNamespace()}},
+		&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: owner.GetName(), Namespace: owner.GetNamespace()}},
+	}
+	if providerObjectKind != "" {
+		candidates = append(candidates, r.createProviderObject(owner, providerObjectKind))
+	}
+
+	for _, candidate := range candidates {
+		key := types.NamespacedName{Name: candidate.GetName(), Namespace: candidate.GetNamespace()}
+		if err := r.Get(ctx, key, candidate); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if len(candidate.GetOwnerReferences()) > 0 {
+			continue
+		}
+		if err := ctrl.SetControllerReference(owner, candidate, r.Scheme); err != nil {
+			return err
+		}
+		if err := r.Update(ctx, candidate); err != nil {
+			logger.Error(err, "Error adopting resource", "name", candidate.GetName(), "namespace", candidate.GetNamespace())
+			return err
+		}
+		logger.Info("adopted pre-existing resource", "name", candidate.GetName(), "namespace", candidate.GetNamespace())
+	}
+	return nil
+}
+
+// mapProviderObjectToOwner maps a provider-specific CR, Role or RoleBinding
+// back to the DBaaSConnection/DBaaSInventory it was created for, by name
+// and namespace.
+func mapProviderObjectToOwner(o client.Object) []ctrl.Request {
+	return []ctrl.Request{{NamespacedName: types.NamespacedName{Name: o.GetName(), Namespace: o.GetNamespace()}}}
+}
+
+// mapCRDToProviders maps a newly-installed CRD to the DBaaSConnections and
+// DBaaSInventories whose referenced DBaaSProvider declares the CRD's kind
+// as its connectionKind or inventoryKind, so that installing a provider
+// operator triggers adoption of any already-existing connections and
+// inventories for it.
+func (r *AdoptionReconciler) mapCRDToProviders(o client.Object) []ctrl.Request {
+	crd, ok := o.(*extv1.CustomResourceDefinition)
+	if !ok {
+		return nil
+	}
+	kind := crd.Spec.Names.Kind
+	ctx := context.Background()
+
+	var providers v1alpha1.DBaaSProviderList
+	if err := r.List(ctx, &providers); err != nil {
+		return nil
+	}
+
+	matchingProviders := map[string]bool{}
+	wantConnections, wantInventories := false, false
+	for _, provider := range providers.Items {
+		if kind == provider.Spec.ConnectionKind {
+			matchingProviders[provider.Name] = true
+			wantConnections = true
+		}
+		if kind == provider.Spec.InventoryKind {
+			matchingProviders[provider.Name] = true
+			wantInventories = true
+		}
+	}
+	if len(matchingProviders) == 0 {
+		return nil
+	}
+
+	var inventories v1alpha1.DBaaSInventoryList
+	if err := r.List(ctx, &inventories); err != nil {
+		return nil
+	}
+	// inventoryProvider indexes every inventory's provider name once so the
+	// connection pass below doesn't need a Get per connection.
+	inventoryProvider := make(map[types.NamespacedName]string, len(inventories.Items))
+	for _, inventory := range inventories.Items {
+		inventoryProvider[types.NamespacedName{Name: inventory.Name, Namespace: inventory.Namespace}] = inventory.Spec.ProviderRef.Name
+	}
+
+	var requests []ctrl.Request
+	if wantInventories {
+		for _, inventory := range inventories.Items {
+			if matchingProviders[inventory.Spec.ProviderRef.Name] {
+				requests = append(requests, ctrl.Request{NamespacedName: types.NamespacedName{Name: inventory.Name, Namespace: inventory.Namespace}})
+			}
+		}
+	}
+	if wantConnections {
+		var connections v1alpha1.DBaaSConnectionList
+		if err := r.List(ctx, &connections); err != nil {
+			return requests
+		}
+		for _, c := range connections.Items {
+			invKey := types.NamespacedName{Name: c.Spec.InventoryRef.Name, Namespace: c.Spec.InventoryRef.Namespace}
+			if matchingProviders[inventoryProvider[invKey]] {
+				requests = append(requests, ctrl.Request{NamespacedName: types.NamespacedName{Name: c.Name, Namespace: c.Namespace}})
+			}
+		}
+	}
+	return requests
+}
+
+// orphaned matches objects that don't yet have an ownerReference, so the
+// adoption watches below only trigger reconciles for resources that still
+// need adopting.
+var orphaned = predicate.NewPredicateFuncs(func(o client.Object) bool {
+	return len(o.GetOwnerReferences()) == 0
+})
+
+// crdCreated matches only CRD Create events: an already-installed CRD
+// doesn't need to re-trigger adoption on every subsequent update.
+var crdCreated = predicate.Funcs{
+	CreateFunc:  func(event.CreateEvent) bool { return true },
+	UpdateFunc:  func(event.UpdateEvent) bool { return false },
+	DeleteFunc:  func(event.DeleteEvent) bool { return false },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
+
+func (r *AdoptionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	var providers v1alpha1.DBaaSProviderList
+	if err := mgr.GetAPIReader().List(context.Background(), &providers); err != nil {
+		return err
+	}
+
+	bldr := ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.DBaaSConnection{}).
+		Watches(&source.Kind{Type: &v1alpha1.DBaaSInventory{}}, handler.EnqueueRequestsFromMapFunc(mapProviderObjectToOwner)).
+		Watches(&source.Kind{Type: &rbacv1.Role{}}, handler.EnqueueRequestsFromMapFunc(mapProviderObjectToOwner), builder.WithPredicates(orphaned)).
+		Watches(&source.Kind{Type: &rbacv1.RoleBinding{}}, handler.EnqueueRequestsFromMapFunc(mapProviderObjectToOwner), builder.WithPredicates(orphaned)).
+		Watches(&source.Kind{Type: &extv1.CustomResourceDefinition{}}, handler.EnqueueRequestsFromMapFunc(r.mapCRDToProviders), builder.WithPredicates(crdCreated))
+
+	// Watch the same provider-CR Kinds watchDBaaSProviderObject registers
+	// for DBaaSConnection/DBaaSInventory reconcilers, so an orphaned
+	// provider object triggers adoption directly instead of only
+	// incidentally via a Role/RoleBinding/CRD event.
+	seenKinds := map[string]bool{}
+	for _, provider := range providers.Items {
+		for _, kind := range []string{provider.Spec.ConnectionKind, provider.Spec.InventoryKind} {
+			if kind == "" || seenKinds[kind] {
+				continue
+			}
+			seenKinds[kind] = true
+
+			providerObject := &unstructured.Unstructured{}
+			providerObject.SetGroupVersionKind(schema.GroupVersionKind{
+				Group:   v1alpha1.GroupVersion.Group,
+				Version: v1alpha1.GroupVersion.Version,
+				Kind:    kind,
+			})
+			bldr = bldr.Watches(&source.Kind{Type: providerObject}, handler.EnqueueRequestsFromMapFunc(mapProviderObjectToOwner), builder.WithPredicates(orphaned))
+		}
+	}
+
+	return bldr.Complete(r)
+}
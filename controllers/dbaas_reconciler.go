@@ -5,9 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
+	"sync"
 
+	"github.com/go-logr/logr"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -25,10 +29,43 @@ import (
 // InstallNamespaceEnvVar is the constant for env variable INSTALL_NAMESPACE
 var InstallNamespaceEnvVar = "INSTALL_NAMESPACE"
 
+// InstallNamespaceFileEnvVar names the env var pointing at a downward-API
+// mounted file containing the install namespace, used as a last resort
+// fallback for plain kubectl-apply deployments that have neither
+// INSTALL_NAMESPACE nor an OLM-managed CSV to inject it.
+const InstallNamespaceFileEnvVar = "INSTALL_NAMESPACE_FILE"
+
+// serviceAccountNamespaceFile is the namespace file every Pod gets mounted
+// automatically, the same one controller-runtime and OLM-managed pods read.
+const serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// InstallNamespaceSource identifies which lookup strategy resolved the
+// operator's install namespace.
+type InstallNamespaceSource string
+
+const (
+	InstallNamespaceSourceField          InstallNamespaceSource = "explicit-field"
+	InstallNamespaceSourceEnvVar         InstallNamespaceSource = "env-var"
+	InstallNamespaceSourceServiceAccount InstallNamespaceSource = "service-account"
+	InstallNamespaceSourceDownwardAPI    InstallNamespaceSource = "downward-api-file"
+)
+
 type DBaaSReconciler struct {
 	client.Client
 	*runtime.Scheme
+
+	// InstallNamespace retains compatibility with manager wiring built
+	// against the old env-only contract, which constructs a DBaaSReconciler
+	// with this field already set. When non-empty it short-circuits
+	// ResolveInstallNamespace's lookup chain.
 	InstallNamespace string
+
+	// installNamespaceMu guards installNamespace/installNamespaceSource,
+	// which are written from ResolveInstallNamespace and read concurrently
+	// by every reconciler embedding this *DBaaSReconciler.
+	installNamespaceMu     sync.Mutex
+	installNamespace       string
+	installNamespaceSource InstallNamespaceSource
 }
 
 func (p *DBaaSReconciler) getDBaaSProvider(providerName string, ctx context.Context) (v1alpha1.DBaaSProvider, error) {
@@ -73,7 +110,24 @@ func (p *DBaaSReconciler) createProviderObject(object client.Object, providerObj
 	return &providerObject
 }
 
-func (p *DBaaSReconciler) reconcileProviderObject(providerObject *unstructured.Unstructured, mutateFn controllerutil.MutateFn, ctx context.Context) (controllerutil.OperationResult, error) {
+// reconcileProviderObject creates or updates providerObject, refusing to do
+// so when providerName's DBaaSProvider is not reporting ProviderAvailable,
+// so that DBaaSConnection and DBaaSInventory reconcilers surface a clear
+// "provider not ready" error instead of failing silently against an
+// unready provider.
+//
+// No DBaaSConnection/DBaaSInventory reconciler exists in this tree yet, so
+// this has no call site here; it's the extension point those reconcilers
+// should call into instead of calling controllerutil.CreateOrUpdate
+// directly.
+func (p *DBaaSReconciler) reconcileProviderObject(providerObject *unstructured.Unstructured, mutateFn controllerutil.MutateFn, providerName string, ctx context.Context) (controllerutil.OperationResult, error) {
+	available, err := p.isProviderAvailable(providerName, ctx)
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+	if !available {
+		return controllerutil.OperationResultNone, fmt.Errorf("provider %s is not available: refusing to reconcile %s", providerName, providerObject.GetKind())
+	}
 	return controllerutil.CreateOrUpdate(ctx, p.Client, providerObject, mutateFn)
 }
 
@@ -147,19 +201,116 @@ func (r *DBaaSReconciler) createRbacObj(newObj, getObj, owner client.Object, ctx
 	return false, nil
 }
 
-// GetInstallNamespace returns the operator's install Namespace
-func GetInstallNamespace() (string, error) {
-	ns, found := os.LookupEnv(InstallNamespaceEnvVar)
-	if !found {
-		return "", fmt.Errorf("%s must be set", InstallNamespaceEnvVar)
+// isProviderAvailable reports whether the named DBaaSProvider currently has
+// its ProviderAvailable condition set to true, so that DBaaSConnection and
+// DBaaSInventory reconcilers can refuse to create provider objects (or mark
+// themselves Degraded) instead of failing silently against an unready
+// provider.
+func (p *DBaaSReconciler) isProviderAvailable(providerName string, ctx context.Context) (bool, error) {
+	provider, err := p.getDBaaSProvider(providerName, ctx)
+	if err != nil {
+		return false, err
+	}
+	return apimeta.IsStatusConditionTrue(provider.Status.Conditions, ProviderAvailableCondition), nil
+}
+
+// ResolveInstallNamespace returns the operator's install namespace, trying
+// in order: the InstallNamespace field (for manager wiring built against
+// the old env-only contract), the INSTALL_NAMESPACE env var, the namespace
+// file every Pod is mounted with (as controller-runtime and OLM-managed
+// pods read it), and finally a downward-API-mounted file named by
+// INSTALL_NAMESPACE_FILE. This lets the operator run under plain
+// kubectl-apply manifests, which have no OLM CSV to inject
+// INSTALL_NAMESPACE. The result is cached on the reconciler after the
+// first successful lookup, and the winning source is logged once.
+func (p *DBaaSReconciler) ResolveInstallNamespace(ctx context.Context) (string, InstallNamespaceSource, error) {
+	p.installNamespaceMu.Lock()
+	defer p.installNamespaceMu.Unlock()
+
+	if p.installNamespace != "" {
+		return p.installNamespace, p.installNamespaceSource, nil
+	}
+
+	logger := ctrl.LoggerFrom(ctx)
+
+	if p.InstallNamespace != "" {
+		p.cacheInstallNamespace(p.InstallNamespace, InstallNamespaceSourceField, logger)
+		return p.InstallNamespace, InstallNamespaceSourceField, nil
+	}
+
+	if ns, found := os.LookupEnv(InstallNamespaceEnvVar); found && ns != "" {
+		p.cacheInstallNamespace(ns, InstallNamespaceSourceEnvVar, logger)
+		return ns, InstallNamespaceSourceEnvVar, nil
+	}
+
+	if ns, err := readNamespaceFile(serviceAccountNamespaceFile); err == nil {
+		p.cacheInstallNamespace(ns, InstallNamespaceSourceServiceAccount, logger)
+		return ns, InstallNamespaceSourceServiceAccount, nil
+	}
+
+	if file, found := os.LookupEnv(InstallNamespaceFileEnvVar); found {
+		if ns, err := readNamespaceFile(file); err == nil {
+			p.cacheInstallNamespace(ns, InstallNamespaceSourceDownwardAPI, logger)
+			return ns, InstallNamespaceSourceDownwardAPI, nil
+		}
+	}
+
+	return "", "", fmt.Errorf("unable to resolve install namespace: set %s, mount a service account, or set %s", InstallNamespaceEnvVar, InstallNamespaceFileEnvVar)
+}
+
+func (p *DBaaSReconciler) cacheInstallNamespace(ns string, source InstallNamespaceSource, logger logr.Logger) {
+	p.installNamespace = ns
+	p.installNamespaceSource = source
+	logger.Info("resolved install namespace", "namespace", ns, "source", source)
+}
+
+func readNamespaceFile(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	ns := strings.TrimSpace(string(b))
+	if ns == "" {
+		return "", fmt.Errorf("%s is empty", path)
 	}
 	return ns, nil
 }
 
+// GetInstallNamespace returns the operator's install Namespace.
+//
+// It tries the same INSTALL_NAMESPACE, service-account and
+// INSTALL_NAMESPACE_FILE sources as ResolveInstallNamespace, in the same
+// order, but re-reads them on every call.
+//
+// Deprecated: use (*DBaaSReconciler).ResolveInstallNamespace, which also
+// honours the InstallNamespace field, caches the result and logs which
+// source won. Kept for existing call sites built against the old env-only
+// contract.
+func GetInstallNamespace() (string, error) {
+	if ns, found := os.LookupEnv(InstallNamespaceEnvVar); found && ns != "" {
+		return ns, nil
+	}
+	if ns, err := readNamespaceFile(serviceAccountNamespaceFile); err == nil {
+		return ns, nil
+	}
+	if file, found := os.LookupEnv(InstallNamespaceFileEnvVar); found {
+		if ns, err := readNamespaceFile(file); err == nil {
+			return ns, nil
+		}
+	}
+	return "", fmt.Errorf("unable to resolve install namespace: set %s, mount a service account, or set %s", InstallNamespaceEnvVar, InstallNamespaceFileEnvVar)
+}
+
 // create an rbac subject for use in role bindings
 func getSubject(name, namespace, rbacObjectKind string) rbacv1.Subject {
+	apiGroup := rbacv1.SchemeGroupVersion.Group
+	if rbacObjectKind == rbacv1.ServiceAccountKind {
+		// ServiceAccount subjects are in the core API group, and the
+		// apiserver rejects the RoleBinding if APIGroup is set for them.
+		apiGroup = ""
+	}
 	return rbacv1.Subject{
-		APIGroup:  rbacv1.SchemeGroupVersion.Group,
+		APIGroup:  apiGroup,
 		Kind:      rbacObjectKind,
 		Name:      name,
 		Namespace: namespace,
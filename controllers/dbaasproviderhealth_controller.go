@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/RHEcosystemAppEng/dbaas-operator/api/v1alpha1"
+)
+
+// providerLabel is set on the Deployments owned by a DBaaSProvider so the
+// health check controller can find them without relying on ownerReferences
+// that cross CRD boundaries.
+const providerLabel = "dbaas.redhat.com/provider"
+
+// ProviderAvailableCondition reflects whether a DBaaSProvider's backing
+// Deployments are up and serving traffic.
+const ProviderAvailableCondition = "ProviderAvailable"
+
+// healthCheckRequeueInterval bounds how long a provider can be unavailable
+// before we notice a late-arriving readiness flip (e.g. pods crash-looping
+// shortly after a Deployment reports Available=True).
+const healthCheckRequeueInterval = 30 * time.Second
+
+// DBaaSProviderHealthReconciler reconciles the health of the Deployments
+// installed by a DBaaSProvider and reports it back on the provider status.
+type DBaaSProviderHealthReconciler struct {
+	*DBaaSReconciler
+}
+
+//+kubebuilder:rbac:groups=dbaas.redhat.com,resources=dbaasproviders,verbs=get;list;watch
+//+kubebuilder:rbac:groups=dbaas.redhat.com,resources=dbaasproviders/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch
+
+func (r *DBaaSProviderHealthReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	var provider v1alpha1.DBaaSProvider
+	if err := r.Get(ctx, req.NamespacedName, &provider); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	var deployments appsv1.DeploymentList
+	if err := r.List(ctx, &deployments, client.MatchingLabels{providerLabel: provider.Name}); err != nil {
+		logger.Error(err, "Error listing Deployments for provider", "provider", provider.Name)
+		return ctrl.Result{}, err
+	}
+
+	condition := metav1.Condition{
+		Type:   ProviderAvailableCondition,
+		Status: metav1.ConditionTrue,
+		Reason: "DeploymentsReady",
+	}
+	if len(deployments.Items) == 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "NoDeploymentsFound"
+		condition.Message = "no Deployments found for provider " + provider.Name
+	} else if unready := unreadyDeploymentNames(deployments.Items); len(unready) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "DeploymentsNotReady"
+		condition.Message = "not ready: " + strings.Join(unready, ", ")
+	}
+	apimeta.SetStatusCondition(&provider.Status.Conditions, condition)
+
+	if err := r.Status().Update(ctx, &provider); err != nil {
+		logger.Error(err, "Error updating provider status", "provider", provider.Name)
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: healthCheckRequeueInterval}, nil
+}
+
+// unreadyDeploymentNames returns the names of the Deployments that are not
+// yet available.
+func unreadyDeploymentNames(deployments []appsv1.Deployment) []string {
+	unready := make([]string, 0, len(deployments))
+	for _, deployment := range deployments {
+		if !deploymentAvailable(deployment) {
+			unready = append(unready, deployment.Name)
+		}
+	}
+	return unready
+}
+
+// deploymentAvailable reports whether a Deployment's Available condition is
+// true and all its replicas are actually ready, catching the window where a
+// Deployment is marked Available but its Pods have since crash-looped.
+func deploymentAvailable(deployment appsv1.Deployment) bool {
+	available := false
+	for _, cond := range deployment.Status.Conditions {
+		if cond.Type == appsv1.DeploymentAvailable && cond.Status == "True" {
+			available = true
+			break
+		}
+	}
+	if !available {
+		return false
+	}
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+	return deployment.Status.ReadyReplicas == desired
+}
+
+func (r *DBaaSProviderHealthReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	ownedByProvider, err := predicate.LabelSelectorPredicate(metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{
+			Key:      providerLabel,
+			Operator: metav1.LabelSelectorOpExists,
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.DBaaSProvider{}).
+		Watches(
+			&source.Kind{Type: &appsv1.Deployment{}},
+			handler.EnqueueRequestsFromMapFunc(func(o client.Object) []ctrl.Request {
+				name, ok := o.GetLabels()[providerLabel]
+				if !ok {
+					return nil
+				}
+				return []ctrl.Request{{NamespacedName: types.NamespacedName{Name: name}}}
+			}),
+			builder.WithPredicates(ownedByProvider),
+		).
+		Complete(r)
+}
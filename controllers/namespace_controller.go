@@ -0,0 +1,154 @@
+package controllers
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/RHEcosystemAppEng/dbaas-operator/api/v1alpha1"
+)
+
+// TenantLabel marks a Namespace as belonging to a DBaaSTenant, so that the
+// NamespaceReconciler can materialize the tenant's developer RBAC into it
+// without requiring the namespace to be pre-declared on the tenant CR.
+const TenantLabel = "dbaas.redhat.com/tenant"
+
+// NamespaceReconciler watches Namespaces and, for those carrying the
+// TenantLabel, ensures the developer Role/RoleBinding set for the matching
+// DBaaSTenant exists in that namespace.
+type NamespaceReconciler struct {
+	*DBaaSReconciler
+}
+
+//+kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+//+kubebuilder:rbac:groups=dbaas.redhat.com,resources=dbaastenants,verbs=get;list;watch
+//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create
+//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update
+
+func (r *NamespaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	var namespace corev1.Namespace
+	if err := r.Get(ctx, req.NamespacedName, &namespace); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	tenantName, ok := namespace.Labels[TenantLabel]
+	if !ok {
+		return ctrl.Result{}, nil
+	}
+
+	var tenant v1alpha1.DBaaSTenant
+	if err := r.Get(ctx, types.NamespacedName{Name: tenantName}, &tenant); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("tenant not found for labeled namespace", "tenant", tenantName, "namespace", namespace.Name)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileTenantRbacObjs(ctx, &tenant, namespace.Name); err != nil {
+		logger.Error(err, "Error reconciling tenant RBAC for namespace", "tenant", tenantName, "namespace", namespace.Name)
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// reconcileTenantRbacObjs ensures the developer Role and RoleBinding for
+// tenant exist in namespace, owned by the Namespace so they're garbage
+// collected when it's deleted.
+func (r *NamespaceReconciler) reconcileTenantRbacObjs(ctx context.Context, tenant *v1alpha1.DBaaSTenant, namespace string) error {
+	var ns corev1.Namespace
+	if err := r.Get(ctx, types.NamespacedName{Name: namespace}, &ns); err != nil {
+		return err
+	}
+
+	developerRole := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tenant.Name + "-developer",
+			Namespace: namespace,
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{v1alpha1.GroupVersion.Group},
+				Resources: []string{"dbaasconnections"},
+				Verbs:     []string{"get", "list", "watch", "create"},
+			},
+		},
+	}
+	if _, err := r.createRbacObj(developerRole, &rbacv1.Role{}, &ns, ctx); err != nil {
+		return err
+	}
+
+	developer := tenant.Spec.Authz.Developer
+	var subjects []rbacv1.Subject
+	for _, user := range uniqueStr(developer.Users) {
+		subjects = append(subjects, getSubject(user, "", rbacv1.UserKind))
+	}
+	for _, group := range uniqueStr(developer.Groups) {
+		subjects = append(subjects, getSubject(group, "", rbacv1.GroupKind))
+	}
+	for _, sa := range uniqueStr(developer.ServiceAccounts) {
+		subjects = append(subjects, getSubject(sa, namespace, rbacv1.ServiceAccountKind))
+	}
+
+	developerRoleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tenant.Name + "-developer",
+			Namespace: namespace,
+		},
+	}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, developerRoleBinding, func() error {
+		developerRoleBinding.Subjects = subjects
+		developerRoleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.SchemeGroupVersion.Group,
+			Kind:     "Role",
+			Name:     developerRole.Name,
+		}
+		return ctrl.SetControllerReference(&ns, developerRoleBinding, r.Scheme)
+	})
+	return err
+}
+
+// mapTenantToNamespaces maps a DBaaSTenant to the Namespaces that carry its
+// TenantLabel, so changes to the tenant's authz stanza re-reconcile every
+// namespace that depends on it.
+func (r *NamespaceReconciler) mapTenantToNamespaces(ctx context.Context, o client.Object) []ctrl.Request {
+	tenant, ok := o.(*v1alpha1.DBaaSTenant)
+	if !ok {
+		return nil
+	}
+
+	var namespaces corev1.NamespaceList
+	if err := r.List(ctx, &namespaces, client.MatchingLabels{TenantLabel: tenant.Name}); err != nil {
+		return nil
+	}
+
+	requests := make([]ctrl.Request, 0, len(namespaces.Items))
+	for _, ns := range namespaces.Items {
+		requests = append(requests, ctrl.Request{NamespacedName: types.NamespacedName{Name: ns.Name}})
+	}
+	return requests
+}
+
+func (r *NamespaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Namespace{}).
+		Watches(
+			&source.Kind{Type: &v1alpha1.DBaaSTenant{}},
+			handler.EnqueueRequestsFromMapFunc(func(o client.Object) []ctrl.Request {
+				return r.mapTenantToNamespaces(context.Background(), o)
+			}),
+		).
+		Complete(r)
+}